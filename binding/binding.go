@@ -0,0 +1,135 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Content-Type MIME of the most common data formats.
+const (
+	MIMEJSON              = "application/json"
+	MIMEHTML              = "text/html"
+	MIMEXML               = "application/xml"
+	MIMEXML2              = "text/xml"
+	MIMEPlain             = "text/plain"
+	MIMEPOSTForm          = "application/x-www-form-urlencoded"
+	MIMEMultipartPOSTForm = "multipart/form-data"
+	MIMEPROTOBUF          = "application/x-protobuf"
+	MIMEMSGPACK           = "application/x-msgpack"
+	MIMEMSGPACK2          = "application/msgpack"
+	MIMEYAML              = "application/x-yaml"
+)
+
+// Binding describes the interface which needs to be implemented for binding
+// the data present in the request such as JSON request body, query parameters
+// or the form POST.
+type Binding interface {
+	Name() string
+	Bind(*http.Request, interface{}) error
+}
+
+// BindingBody is an extension of Binding which reads the body from a slice of
+// bytes instead of req.Body. It is useful when the body needs to be read more
+// than once, since req.Body can only be consumed a single time.
+type BindingBody interface {
+	Binding
+	BindBody([]byte, interface{}) error
+}
+
+// BindingURI is an extension of Binding which binds the data present in the
+// path variables captured by the router.
+type BindingURI interface {
+	Name() string
+	BindURI(map[string][]string, interface{}) error
+}
+
+// These implement the Binding interface and can be used to bind the data
+// present in the request to struct instances.
+var (
+	JSON          BindingBody = jsonBinding{}
+	XML           BindingBody = xmlBinding{}
+	Form          Binding     = formBinding{}
+	FormPost      Binding     = formPostBinding{}
+	FormMultipart Binding     = formMultipartBinding{}
+	ProtoBuf      BindingBody = protobufBinding{}
+	MsgPack       BindingBody = msgpackBinding{}
+	YAML          BindingBody = yamlBinding{}
+	Header        Binding     = headerBinding{}
+	Cookie        Binding     = cookieBinding{}
+)
+
+// bindings holds the registry of content-type -> Binding used by Default.
+// It is seeded with the built-in bindings and can be extended at runtime
+// through RegisterBinding, allowing applications to add support for
+// additional content types or replace the implementation of an existing one.
+// MIMEMultipartPOSTForm is deliberately not seeded here: Default has always
+// returned Form for it (Form.Bind falls back to ParseMultipartForm itself),
+// and FormMultipart remains available for callers who select it explicitly.
+var (
+	bindingsMu sync.RWMutex
+	bindings   = map[string]Binding{
+		MIMEJSON:     JSON,
+		MIMEXML:      XML,
+		MIMEXML2:     XML,
+		MIMEPROTOBUF: ProtoBuf,
+		MIMEMSGPACK:  MsgPack,
+		MIMEMSGPACK2: MsgPack,
+		MIMEYAML:     YAML,
+	}
+)
+
+// RegisterBinding associates a content-type with a Binding so that Default
+// will return it for requests carrying that content-type. It can also be
+// used to override one of the built-in bindings.
+func RegisterBinding(mime string, b Binding) {
+	bindingsMu.Lock()
+	defer bindingsMu.Unlock()
+	bindings[mime] = b
+}
+
+// Default returns the appropriate Binding instance based on the HTTP method
+// and the content type.
+func Default(method, contentType string) Binding {
+	if method == "GET" {
+		return Form
+	}
+
+	bindingsMu.RLock()
+	defer bindingsMu.RUnlock()
+	if b, ok := bindings[contentType]; ok {
+		return b
+	}
+	return Form
+}
+
+// StructValidator is the minimal interface which needs to be implemented in
+// order for a custom validator to be used instead of the default one. The
+// field must satisfy the `binding` tag on each field of a struct.
+type StructValidator interface {
+	// ValidateStruct receives any kind of type and should determine whether
+	// it needs to be validated. If it is a struct or pointer to a struct,
+	// then the validation should be performed. Otherwise, nil should be
+	// returned so binding continues.
+	ValidateStruct(interface{}) error
+
+	// Engine returns the underlying validator engine which powers the
+	// current implementation. This is usually used to register custom
+	// validations or struct level validations, e.g. Validator.Engine().(*validator.Validate).
+	Engine() interface{}
+}
+
+// Validator is the default validator used by Bind to enforce the `binding`
+// struct tags. Setting it to nil disables validation entirely; replacing it
+// lets applications plug in a different validation engine.
+var Validator StructValidator = &defaultValidator{}
+
+func validate(obj interface{}) error {
+	if Validator == nil {
+		return nil
+	}
+	return Validator.ValidateStruct(obj)
+}
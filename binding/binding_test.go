@@ -9,6 +9,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin/binding/example"
 	"github.com/golang/protobuf/proto"
@@ -60,6 +61,12 @@ func TestBindingDefault(t *testing.T) {
 
 	assert.Equal(t, Default("POST", MIMEPROTOBUF), ProtoBuf)
 	assert.Equal(t, Default("PUT", MIMEPROTOBUF), ProtoBuf)
+
+	assert.Equal(t, Default("POST", MIMEMSGPACK), MsgPack)
+	assert.Equal(t, Default("PUT", MIMEMSGPACK2), MsgPack)
+
+	assert.Equal(t, Default("POST", MIMEYAML), YAML)
+	assert.Equal(t, Default("PUT", MIMEYAML), YAML)
 }
 
 func TestBindingJSON(t *testing.T) {
@@ -243,6 +250,87 @@ func TestFormBindingWithPointers2(t *testing.T) {
 	assert.EqualValues(t, *obj.String, "foo")
 }
 
+func TestFormBindingDefaultValue(t *testing.T) {
+	var obj struct {
+		Foo string `form:"foo,default=hello"`
+		Bar int    `form:"bar,default=42"`
+	}
+	req := requestWithBody("GET", "/?bar=", "")
+	assert.NoError(t, Form.Bind(req, &obj))
+	assert.Equal(t, "hello", obj.Foo)
+	assert.Equal(t, 42, obj.Bar)
+
+	obj.Foo, obj.Bar = "", 0
+	req = requestWithBody("GET", "/?foo=world&bar=7", "")
+	assert.NoError(t, Form.Bind(req, &obj))
+	assert.Equal(t, "world", obj.Foo)
+	assert.Equal(t, 7, obj.Bar)
+}
+
+func TestFormBindingTime(t *testing.T) {
+	var obj struct {
+		CreatedAt time.Time  `form:"created_at" time_format:"2006-01-02"`
+		UpdatedAt *time.Time `form:"updated_at" time_format:"2006-01-02" time_utc:"1"`
+	}
+	req := requestWithBody("GET", "/?created_at=2019-01-20&updated_at=2019-01-21", "")
+	assert.NoError(t, Form.Bind(req, &obj))
+	assert.Equal(t, 2019, obj.CreatedAt.Year())
+	assert.Equal(t, time.Month(1), obj.CreatedAt.Month())
+	assert.Equal(t, 20, obj.CreatedAt.Day())
+	if assert.NotNil(t, obj.UpdatedAt) {
+		assert.Equal(t, 21, obj.UpdatedAt.Day())
+	}
+}
+
+func TestFormBindingMap(t *testing.T) {
+	var obj struct {
+		Filter map[string]string `form:"filter"`
+	}
+	req := requestWithBody("GET", "/?filter[status]=active&filter[kind]=foo", "")
+	assert.NoError(t, Form.Bind(req, &obj))
+	assert.Equal(t, "active", obj.Filter["status"])
+	assert.Equal(t, "foo", obj.Filter["kind"])
+}
+
+func TestFormBindingMapRejectsNonStringKey(t *testing.T) {
+	var obj struct {
+		Filter map[int]string `form:"filter"`
+	}
+	req := requestWithBody("GET", "/?filter[1]=active", "")
+	assert.Error(t, Form.Bind(req, &obj))
+	assert.Nil(t, obj.Filter)
+}
+
+func TestFormBindingMapRejectsUnsupportedElem(t *testing.T) {
+	var obj struct {
+		Filter map[string]interface{} `form:"filter"`
+	}
+	req := requestWithBody("GET", "/", "")
+	assert.Error(t, Form.Bind(req, &obj))
+	assert.Nil(t, obj.Filter)
+}
+
+func TestFormBindingArray(t *testing.T) {
+	var obj struct {
+		IDs [2]int `form:"ids"`
+	}
+	req := requestWithBody("GET", "/?ids=1&ids=2", "")
+	assert.NoError(t, Form.Bind(req, &obj))
+	assert.Equal(t, [2]int{1, 2}, obj.IDs)
+}
+
+func TestFormBindingEmbeddedUnexportedStruct(t *testing.T) {
+	type inner struct {
+		Name string `form:"name"`
+	}
+	var obj struct {
+		inner
+	}
+	req := requestWithBody("GET", "/?name=gin", "")
+	assert.NoError(t, Form.Bind(req, &obj))
+	assert.Equal(t, "gin", obj.Name)
+}
+
 func testBodyBinding(t *testing.T, b Binding, name, path, badPath, body, badBody string) {
 	assert.Equal(t, b.Name(), name)
 
@@ -279,3 +367,22 @@ func requestWithBody(method, path, body string) (req *http.Request) {
 	req, _ = http.NewRequest(method, path, bytes.NewBufferString(body))
 	return
 }
+
+func TestRegisterBinding(t *testing.T) {
+	assert.Equal(t, Default("POST", "application/x-custom"), Form)
+
+	RegisterBinding("application/x-custom", JSON)
+	assert.Equal(t, Default("POST", "application/x-custom"), JSON)
+}
+
+func TestBindingBodyRebind(t *testing.T) {
+	body := []byte(`{"foo": "bar", "bar": "foo"}`)
+
+	var a FooStruct
+	assert.NoError(t, JSON.BindBody(body, &a))
+	assert.Equal(t, a.Foo, "bar")
+
+	var b FooBarStruct
+	assert.NoError(t, JSON.BindBody(body, &b))
+	assert.Equal(t, b.Bar, "foo")
+}
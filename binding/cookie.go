@@ -0,0 +1,29 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import "net/http"
+
+type cookieBinding struct{}
+
+func (cookieBinding) Name() string {
+	return "cookie"
+}
+
+// Bind maps the request's cookies into obj using the "cookie" struct tag,
+// for example a field declared as Session string with tag cookie:"sid".
+// Values are filled in through mapFormByTag, so a cookie the client didn't
+// send leaves its field untouched; Bind only returns an error for it when
+// the field is also tagged binding:"required".
+func (cookieBinding) Bind(req *http.Request, obj interface{}) error {
+	cookies := make(map[string][]string)
+	for _, c := range req.Cookies() {
+		cookies[c.Name] = append(cookies[c.Name], c.Value)
+	}
+	if err := mapFormByTag(obj, cookies, "cookie"); err != nil {
+		return err
+	}
+	return validate(obj)
+}
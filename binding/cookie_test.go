@@ -0,0 +1,38 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCookieBinding(t *testing.T) {
+	type cookieStruct struct {
+		Session string  `cookie:"sid"`
+		Theme   *string `cookie:"theme"`
+	}
+
+	assert.Equal(t, Cookie.Name(), "cookie")
+
+	var obj cookieStruct
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.AddCookie(&http.Cookie{Name: "sid", Value: "abc123"})
+	assert.NoError(t, Cookie.Bind(req, &obj))
+	assert.Equal(t, "abc123", obj.Session)
+	assert.Nil(t, obj.Theme)
+}
+
+func TestCookieBindingMissingRequired(t *testing.T) {
+	type cookieStruct struct {
+		Session string `cookie:"sid" binding:"required"`
+	}
+
+	var obj cookieStruct
+	req, _ := http.NewRequest("GET", "/", nil)
+	assert.Error(t, Cookie.Bind(req, &obj))
+}
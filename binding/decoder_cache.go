@@ -0,0 +1,188 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"net/textproto"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// fieldDecoder is a pre-resolved plan for filling a single struct field from
+// form/query values. Building it requires walking the struct type, reading
+// its tags and switching on its kind, all of which is immutable for a given
+// reflect.Type. decoderCache compiles this plan once per type so that Bind
+// on the hot path only has to walk the cached slice instead of repeating
+// struct-tag reflection on every request. The plan stores kinds, not setter
+// functions: applyFieldDecoders still picks the setter for each field by
+// switching on its kind at bind time.
+type fieldDecoder struct {
+	fieldIndex          int
+	anonymousUnexported bool
+	recurse             bool // field is a (non-time) nested struct: recurse into its own plan
+	name                string
+	opts                formTagOptions
+	isMap               bool
+	isTime              bool
+	timeTag             reflect.StructField
+	kind                reflect.Kind // the field's own kind
+	elemKind            reflect.Kind // slice/array element kind, or pointer target kind
+}
+
+// decoderCache holds the compiled []fieldDecoder plan for each struct type
+// seen by mapFormByTag, keyed by the combination of the type and the tag
+// name ("form", "uri", "header" or "cookie") since the same type can be
+// decoded with any of them.
+var decoderCache sync.Map // map[decoderCacheKey][]fieldDecoder
+
+type decoderCacheKey struct {
+	typ reflect.Type
+	tag string
+}
+
+func getFieldDecoders(typ reflect.Type, tag string) []fieldDecoder {
+	key := decoderCacheKey{typ, tag}
+	if cached, ok := decoderCache.Load(key); ok {
+		return cached.([]fieldDecoder)
+	}
+
+	plan := buildFieldDecoders(typ, tag)
+	actual, _ := decoderCache.LoadOrStore(key, plan)
+	return actual.([]fieldDecoder)
+}
+
+func buildFieldDecoders(typ reflect.Type, tag string) []fieldDecoder {
+	plan := make([]fieldDecoder, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		typeField := typ.Field(i)
+
+		fieldType := typeField.Type
+		isUnexported := typeField.PkgPath != ""
+		if isUnexported && !(typeField.Anonymous && fieldType.Kind() == reflect.Struct) {
+			// Unexported fields can never be set, except for an embedded
+			// struct field whose own fields we can still reach below.
+			continue
+		}
+
+		structFieldKind := fieldType.Kind()
+		fd := fieldDecoder{fieldIndex: i, anonymousUnexported: isUnexported, kind: structFieldKind}
+		isTimePtr := structFieldKind == reflect.Ptr && isTimeType(fieldType.Elem())
+		fd.isTime = isTimeType(fieldType) || isTimePtr
+		fd.timeTag = typeField
+
+		switch {
+		case structFieldKind == reflect.Slice || structFieldKind == reflect.Array:
+			fd.elemKind = fieldType.Elem().Kind()
+		case structFieldKind == reflect.Ptr:
+			fd.elemKind = fieldType.Elem().Kind()
+		}
+
+		name, opts := parseFormTag(typeField.Tag.Get(tag))
+		fd.name = name
+		fd.opts = opts
+
+		if tag == "header" && fd.name != "" {
+			// req.Header is keyed by canonical MIME header name regardless
+			// of how the client sent it, so an explicit tag must be looked
+			// up the same way rather than matched literally.
+			fd.name = textproto.CanonicalMIMEHeaderKey(fd.name)
+		}
+		if fd.name == "" {
+			fd.name = typeField.Name
+			if structFieldKind == reflect.Struct && !fd.isTime {
+				fd.recurse = true
+			}
+		}
+		fd.isMap = structFieldKind == reflect.Map
+
+		plan = append(plan, fd)
+	}
+	return plan
+}
+
+func mapForm(ptr interface{}, form map[string][]string) error {
+	return mapFormByTag(ptr, form, "form")
+}
+
+func mapFormByTag(ptr interface{}, form map[string][]string, tag string) error {
+	val := reflect.ValueOf(ptr).Elem()
+	plan := getFieldDecoders(val.Type(), tag)
+	return applyFieldDecoders(val, plan, form, tag)
+}
+
+// applyFieldDecoders runs an already-built plan against val. It is the hot
+// path mapFormByTag calls after fetching the plan from decoderCache.
+func applyFieldDecoders(val reflect.Value, plan []fieldDecoder, form map[string][]string, tag string) error {
+	for _, fd := range plan {
+		structField := val.Field(fd.fieldIndex)
+		if !structField.CanSet() {
+			if !fd.anonymousUnexported {
+				continue
+			}
+			structField = reflect.NewAt(structField.Type(), unsafe.Pointer(structField.UnsafeAddr())).Elem()
+		}
+
+		if fd.recurse {
+			if err := mapFormByTag(structField.Addr().Interface(), form, tag); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if fd.isMap {
+			if err := setFormMap(structField, fd.name, form); err != nil {
+				return err
+			}
+			continue
+		}
+
+		inputValue, exists := form[fd.name]
+		isEmpty := exists && len(inputValue) > 0 && inputValue[0] == ""
+		if (!exists || isEmpty) && fd.opts.defaultValue != "" {
+			inputValue = []string{fd.opts.defaultValue}
+			exists = true
+		}
+		if !exists {
+			continue
+		}
+
+		if fd.isTime {
+			if err := setTimeField(inputValue[0], fd.timeTag, structField); err != nil {
+				return err
+			}
+			continue
+		}
+
+		numElems := len(inputValue)
+		switch {
+		case fd.kind == reflect.Slice && numElems > 0:
+			slice := reflect.MakeSlice(structField.Type(), numElems, numElems)
+			for i := 0; i < numElems; i++ {
+				if err := setWithProperType(fd.elemKind, inputValue[i], slice.Index(i)); err != nil {
+					return err
+				}
+			}
+			structField.Set(slice)
+		case fd.kind == reflect.Array && numElems > 0:
+			array := reflect.New(structField.Type()).Elem()
+			for i := 0; i < numElems && i < array.Len(); i++ {
+				if err := setWithProperType(fd.elemKind, inputValue[i], array.Index(i)); err != nil {
+					return err
+				}
+			}
+			structField.Set(array)
+		case fd.kind == reflect.Ptr:
+			if err := setWithPointerType(fd.elemKind, inputValue[0], structField); err != nil {
+				return err
+			}
+		default:
+			if err := setWithProperType(fd.kind, inputValue[0], structField); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"reflect"
+	"sync"
+
+	"gopkg.in/go-playground/validator.v8"
+)
+
+type defaultValidator struct {
+	once     sync.Once
+	validate *validator.Validate
+}
+
+var _ StructValidator = &defaultValidator{}
+
+// ValidateStruct receives any kind of type, but only performs struct or
+// pointer to struct validation.
+func (v *defaultValidator) ValidateStruct(obj interface{}) error {
+	value := reflect.ValueOf(obj)
+	valueKind := value.Kind()
+	if valueKind == reflect.Ptr {
+		value = value.Elem()
+		valueKind = value.Kind()
+	}
+	if valueKind != reflect.Struct {
+		return nil
+	}
+
+	v.lazyinit()
+	if err := v.validate.Struct(obj); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Engine returns the underlying *validator.Validate instance, allowing
+// callers to register custom validation functions and tags.
+func (v *defaultValidator) Engine() interface{} {
+	v.lazyinit()
+	return v.validate
+}
+
+func (v *defaultValidator) lazyinit() {
+	v.once.Do(func() {
+		v.validate = validator.New(&validator.Config{TagName: "binding"})
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/go-playground/validator.v8"
+)
+
+func TestDefaultValidatorEngine(t *testing.T) {
+	v := &defaultValidator{}
+	engine, ok := v.Engine().(*validator.Validate)
+	assert.True(t, ok)
+	assert.NotNil(t, engine)
+}
+
+func TestDefaultValidatorSkipsNonStructs(t *testing.T) {
+	v := &defaultValidator{}
+	assert.NoError(t, v.ValidateStruct("not a struct"))
+	assert.NoError(t, v.ValidateStruct(42))
+}
+
+type customValidator struct {
+	called bool
+}
+
+func (c *customValidator) ValidateStruct(interface{}) error {
+	c.called = true
+	return nil
+}
+
+func (c *customValidator) Engine() interface{} {
+	return c
+}
+
+func TestCustomValidatorCanBeInstalled(t *testing.T) {
+	backup := Validator
+	custom := &customValidator{}
+	Validator = custom
+	defer func() { Validator = backup }()
+
+	var obj FooStruct
+	req := requestWithBody("POST", "/", `{"bar": "foo"}`)
+	assert.NoError(t, JSON.Bind(req, &obj))
+	assert.True(t, custom.called)
+	assert.Same(t, custom, Validator.Engine())
+}
+
+func TestValidatorDisabledByNilReturnsNoError(t *testing.T) {
+	backup := Validator
+	Validator = nil
+	defer func() { Validator = backup }()
+
+	assert.NoError(t, validate(FooStruct{}))
+}
@@ -8,61 +8,136 @@ import (
 	"errors"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 )
 
-func mapForm(ptr interface{}, form map[string][]string) error {
-	typ := reflect.TypeOf(ptr).Elem()
-	val := reflect.ValueOf(ptr).Elem()
-	for i := 0; i < typ.NumField(); i++ {
-		typeField := typ.Field(i)
-		structField := val.Field(i)
-		if !structField.CanSet() {
-			continue
+var errUnknownType = errors.New("unknown type")
+
+// formTagOptions holds the extra, comma-separated options that can follow
+// the field name in a tag, e.g. `form:"bar,default=hello"`.
+type formTagOptions struct {
+	defaultValue string
+}
+
+func parseFormTag(tag string) (name string, opts formTagOptions) {
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, opt := range parts[1:] {
+		if v := strings.TrimPrefix(opt, "default="); v != opt {
+			opts.defaultValue = v
 		}
+	}
+	return name, opts
+}
+
+// isSettableKind reports whether setWithProperType knows how to parse a
+// string into the given kind.
+func isSettableKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Bool, reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	default:
+		return false
+	}
+}
 
-		structFieldKind := structField.Kind()
-		inputFieldName := typeField.Tag.Get("form")
-		if inputFieldName == "" {
-			inputFieldName = typeField.Name
-
-			// if "form" tag is nil, we inspect if the field is a struct.
-			// this would not make sense for JSON parsing but it does for a form
-			// since data is flatten
-			if structFieldKind == reflect.Struct {
-				err := mapForm(structField.Addr().Interface(), form)
-				if err != nil {
-					return err
-				}
-				continue
-			}
-		}
-		inputValue, exists := form[inputFieldName]
-		if !exists {
+// setFormMap fills a map[string]T field from "name[key]=value" style form
+// entries, e.g. a field tagged `form:"filter"` is populated from
+// "filter[status]=active&filter[kind]=foo". Only string-keyed maps whose
+// element type setWithProperType can parse are supported.
+func setFormMap(structField reflect.Value, name string, form map[string][]string) error {
+	mapType := structField.Type()
+	if mapType.Key().Kind() != reflect.String {
+		return errUnknownType
+	}
+	elemKind := mapType.Elem().Kind()
+	if !isSettableKind(elemKind) {
+		return errUnknownType
+	}
+
+	prefix := name + "["
+	m := reflect.MakeMap(mapType)
+	for key, values := range form {
+		if !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, "]") || len(values) == 0 {
 			continue
 		}
+		subKey := key[len(prefix) : len(key)-1]
+		elem := reflect.New(mapType.Elem()).Elem()
+		if err := setWithProperType(elemKind, values[0], elem); err != nil {
+			return err
+		}
+		m.SetMapIndex(reflect.ValueOf(subKey), elem)
+	}
+	structField.Set(m)
+	return nil
+}
 
-		numElems := len(inputValue)
-		if structFieldKind == reflect.Slice && numElems > 0 {
-			sliceOf := structField.Type().Elem().Kind()
-			slice := reflect.MakeSlice(structField.Type(), numElems, numElems)
-			for i := 0; i < numElems; i++ {
-				if err := setWithProperType(sliceOf, inputValue[i], slice.Index(i)); err != nil {
-					return err
-				}
-			}
-			val.Field(i).Set(slice)
+func isTimeType(t reflect.Type) bool {
+	return t == reflect.TypeOf(time.Time{})
+}
+
+// setTimeField parses val according to the time_format/time_utc/time_location
+// struct tags (defaulting to time.RFC3339) and assigns it, supporting both
+// time.Time and *time.Time fields.
+func setTimeField(val string, field reflect.StructField, structField reflect.Value) error {
+	timeFormat := field.Tag.Get("time_format")
+	if timeFormat == "" {
+		timeFormat = time.RFC3339
+	}
+
+	if val == "" {
+		return nil
+	}
+
+	switch strings.ToLower(timeFormat) {
+	case "unix":
+		tv, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		return setTimeValue(time.Unix(tv, 0), field, structField)
+	case "unixnano":
+		tv, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		return setTimeValue(time.Unix(0, tv), field, structField)
+	}
+
+	var loc *time.Location
+	if locTag := field.Tag.Get("time_location"); locTag != "" {
+		var err error
+		if loc, err = time.LoadLocation(locTag); err != nil {
+			return err
+		}
+	}
+
+	var t time.Time
+	var err error
+	if loc == nil {
+		if field.Tag.Get("time_utc") != "" {
+			t, err = time.ParseInLocation(timeFormat, val, time.UTC)
 		} else {
-			if typeField.Type.Kind() == reflect.Ptr {
-				if err := setWithPointerType(typeField.Type.Elem().Kind(), inputValue[0], structField); err != nil {
-					return err
-				}
-			} else {
-				if err := setWithProperType(typeField.Type.Kind(), inputValue[0], structField); err != nil {
-					return err
-				}
-			}
+			t, err = time.Parse(timeFormat, val)
 		}
+	} else {
+		t, err = time.ParseInLocation(timeFormat, val, loc)
+	}
+	if err != nil {
+		return err
+	}
+	return setTimeValue(t, field, structField)
+}
+
+func setTimeValue(t time.Time, field reflect.StructField, structField reflect.Value) error {
+	if field.Type.Kind() == reflect.Ptr {
+		structField.Set(reflect.ValueOf(&t))
+		return nil
 	}
+	structField.Set(reflect.ValueOf(t))
 	return nil
 }
 
@@ -175,7 +250,7 @@ func setWithProperType(valueKind reflect.Kind, val string, structField reflect.V
 	case reflect.String:
 		structField.SetString(val)
 	default:
-		return errors.New("Unknown type")
+		return errUnknownType
 	}
 	return nil
 }
@@ -0,0 +1,25 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import "net/http"
+
+type headerBinding struct{}
+
+func (headerBinding) Name() string {
+	return "header"
+}
+
+// Bind maps req.Header into obj using the "header" struct tag, for example
+// a field declared as UserAgent string with tag header:"User-Agent". Values
+// are filled in through mapFormByTag, so a header absent from the request
+// leaves its field untouched; Bind only returns an error for it when the
+// field is also tagged binding:"required".
+func (headerBinding) Bind(req *http.Request, obj interface{}) error {
+	if err := mapFormByTag(obj, req.Header, "header"); err != nil {
+		return err
+	}
+	return validate(obj)
+}
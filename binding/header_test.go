@@ -0,0 +1,79 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderBinding(t *testing.T) {
+	type headerStruct struct {
+		UserAgent string  `header:"User-Agent"`
+		Referer   *string `header:"Referer"`
+	}
+
+	assert.Equal(t, Header.Name(), "header")
+
+	var obj headerStruct
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set("User-Agent", "gin-tests")
+	assert.NoError(t, Header.Bind(req, &obj))
+	assert.Equal(t, "gin-tests", obj.UserAgent)
+	assert.Nil(t, obj.Referer)
+}
+
+func TestHeaderBindingCaseInsensitiveTag(t *testing.T) {
+	type headerStruct struct {
+		UserAgent string `header:"user-agent"`
+	}
+
+	var obj headerStruct
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set("User-Agent", "gin-tests")
+	assert.NoError(t, Header.Bind(req, &obj))
+	assert.Equal(t, "gin-tests", obj.UserAgent)
+}
+
+func TestHeaderBindingUntaggedFallsBackToLiteralName(t *testing.T) {
+	// Untagged fields are matched by their literal Go field name, the same
+	// convention form/cookie/uri binding use, so a single-word header name
+	// like Accept works without a tag. Multi-word headers such as
+	// "User-Agent" still need an explicit tag (see TestHeaderBinding).
+	type headerStruct struct {
+		Accept string
+	}
+
+	var obj headerStruct
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept", "text/html")
+	assert.NoError(t, Header.Bind(req, &obj))
+	assert.Equal(t, "text/html", obj.Accept)
+}
+
+func TestHeaderBindingMultiValued(t *testing.T) {
+	type headerStruct struct {
+		Accept []string `header:"Accept"`
+	}
+
+	var obj headerStruct
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Add("Accept", "text/html")
+	req.Header.Add("Accept", "application/json")
+	assert.NoError(t, Header.Bind(req, &obj))
+	assert.Equal(t, []string{"text/html", "application/json"}, obj.Accept)
+}
+
+func TestHeaderBindingRequired(t *testing.T) {
+	type headerStruct struct {
+		UserAgent string `header:"User-Agent" binding:"required"`
+	}
+
+	var obj headerStruct
+	req, _ := http.NewRequest("GET", "/", nil)
+	assert.Error(t, Header.Bind(req, &obj))
+}
@@ -0,0 +1,44 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ugorji/go/codec"
+)
+
+func createMsgPackBody(t *testing.T, body map[string]interface{}) *bytes.Buffer {
+	h := new(codec.MsgpackHandle)
+	buf := new(bytes.Buffer)
+	assert.NoError(t, codec.NewEncoder(buf, h).Encode(body))
+	return buf
+}
+
+func TestMsgPackBindingBind(t *testing.T) {
+	b := MsgPack
+	assert.Equal(t, b.Name(), "msgpack")
+
+	obj := FooStruct{}
+	req, _ := http.NewRequest("POST", "/", createMsgPackBody(t, map[string]interface{}{"foo": "bar"}))
+	req.Header.Set("Content-Type", MIMEMSGPACK)
+	assert.NoError(t, b.Bind(req, &obj))
+	assert.Equal(t, obj.Foo, "bar")
+
+	obj = FooStruct{}
+	req, _ = http.NewRequest("POST", "/", createMsgPackBody(t, map[string]interface{}{"bar": "foo"}))
+	req.Header.Set("Content-Type", MIMEMSGPACK)
+	assert.Error(t, b.Bind(req, &obj))
+}
+
+func TestMsgPackBindingBindBody(t *testing.T) {
+	obj := FooStruct{}
+	body := createMsgPackBody(t, map[string]interface{}{"foo": "bar"}).Bytes()
+	assert.NoError(t, MsgPack.BindBody(body, &obj))
+	assert.Equal(t, obj.Foo, "bar")
+}
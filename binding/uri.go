@@ -0,0 +1,23 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+type uriBinding struct{}
+
+// Uri implements BindingURI and maps the path parameters captured by the
+// router (e.g. gin.Context.Params) into a struct, using the "uri" tag in
+// the same way Form uses the "form" tag.
+var Uri BindingURI = uriBinding{}
+
+func (uriBinding) Name() string {
+	return "uri"
+}
+
+func (uriBinding) BindURI(m map[string][]string, obj interface{}) error {
+	if err := mapFormByTag(obj, m, "uri"); err != nil {
+		return err
+	}
+	return validate(obj)
+}
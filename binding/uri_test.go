@@ -0,0 +1,30 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUriBinding(t *testing.T) {
+	type Tag struct {
+		Name string `uri:"name"`
+	}
+	var tag Tag
+	m := map[string][]string{
+		"name": {"thinkerou"},
+	}
+	assert.NoError(t, Uri.BindURI(m, &tag))
+	assert.Equal(t, "thinkerou", tag.Name)
+
+	type NotSupportStruct struct {
+		Name map[string]interface{} `uri:"name"`
+	}
+	var not NotSupportStruct
+	assert.Error(t, Uri.BindURI(m, &not))
+	assert.Equal(t, map[string]interface{}(nil), not.Name)
+}
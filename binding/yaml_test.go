@@ -0,0 +1,26 @@
+// Copyright 2014 Manu Martinez-Almeida.  All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"testing"
+)
+
+func TestYAMLBindingBind(t *testing.T) {
+	testBodyBinding(t,
+		YAML, "yaml",
+		"/", "/",
+		"foo: bar", "bar: foo")
+}
+
+func TestYAMLBindingBindBody(t *testing.T) {
+	obj := FooStruct{}
+	if err := YAML.BindBody([]byte("foo: bar"), &obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Foo != "bar" {
+		t.Fatalf("expected foo=bar, got %q", obj.Foo)
+	}
+}